@@ -7,6 +7,13 @@ package conditions
 //   • Conditions are kept lexicographically sorted **inside set** – right
 //     when a condition is created or updated.
 //   • Public helpers (MarkTrue / MarkFalse / MarkUnknown / SyncReady)
+//   • The *Changed variants (MarkTrueChanged / MarkFalseChanged / MarkUnknownChanged /
+//     SyncReadyChanged) report whether the slice actually mutated, so callers can
+//     skip a status write when nothing meaningful changed.
+//   • Severity (WithSeverity) follows the Kubernetes/Cluster-API convention: only
+//     False conditions with Severity=Error (or no Severity set) drag Ready to
+//     False. Warning/Info False
+//     conditions are informational and can be surfaced via SyncReadyWithSummary.
 //   • Resources integrate by implementing the ConditionsAccessor interface.
 
 import (
@@ -33,6 +40,16 @@ const (
 	Unknown ConditionStatus = "Unknown"
 )
 
+// Severity classifies how much a False condition should matter to the
+// aggregate Ready condition, following the Kubernetes/Cluster-API convention.
+type Severity string
+
+const (
+	SeverityError   Severity = "Error"
+	SeverityWarning Severity = "Warning"
+	SeverityInfo    Severity = "Info"
+)
+
 // Condition represents a single entry in the status.conditions array.
 // LastTransitionTime is stored as time.Time (UTC).
 // All json tags follow Kubernetes conventions so the struct can be embedded in
@@ -40,11 +57,28 @@ const (
 type Condition struct {
 	Type               Type            `json:"type"`
 	Status             ConditionStatus `json:"status"`
+	Severity           Severity        `json:"severity,omitempty"`
 	Reason             string          `json:"reason,omitempty"`
 	Message            string          `json:"message,omitempty"`
+	ObservedGeneration int64           `json:"observedGeneration,omitempty"`
 	LastTransitionTime time.Time       `json:"lastTransitionTime,omitempty"`
 }
 
+// MarkOption customizes a Mark* call with fields beyond status/reason/message.
+type MarkOption func(*Condition)
+
+// WithSeverity sets the Severity of the condition being marked. Severity only
+// affects aggregation of False conditions; it is ignored for True and Unknown.
+func WithSeverity(s Severity) MarkOption {
+	return func(c *Condition) { c.Severity = s }
+}
+
+// WithObservedGeneration records the generation of the resource the condition was
+// computed against, so stale status can be detected after a spec update.
+func WithObservedGeneration(gen int64) MarkOption {
+	return func(c *Condition) { c.ObservedGeneration = gen }
+}
+
 // -----------------------------------------------------------------------------
 // Accessor interface – embed this in your Status struct
 // -----------------------------------------------------------------------------
@@ -90,29 +124,39 @@ func get(conds *[]Condition, t Type) *Condition {
 	return nil
 }
 
-// set creates or updates a condition, then keeps the slice sorted.
-func set(conds *[]Condition, t Type, s ConditionStatus, reason, msg string) {
+// set creates or updates a condition, then keeps the slice sorted. It reports
+// whether the condition's Status, Severity, Reason, Message or ObservedGeneration
+// actually changed; LastTransitionTime is only bumped on a real Status transition,
+// not on every call.
+func set(conds *[]Condition, t Type, s ConditionStatus, reason, msg string, opts ...MarkOption) bool {
+	next := Condition{Type: t, Status: s, Reason: reason, Message: msg}
+	for _, opt := range opts {
+		opt(&next)
+	}
+
 	now := time.Now().UTC()
 
 	if cond := get(conds, t); cond != nil {
-		if cond.Reason != reason {
+		if cond.Status == s && cond.Severity == next.Severity && cond.Reason == reason &&
+			cond.Message == msg && cond.ObservedGeneration == next.ObservedGeneration {
+			return false
+		}
+		if cond.Status != s {
 			cond.LastTransitionTime = now
 		}
 		cond.Status = s
+		cond.Severity = next.Severity
 		cond.Reason = reason
 		cond.Message = msg
+		cond.ObservedGeneration = next.ObservedGeneration
 	} else {
-		*conds = append(*conds, Condition{
-			Type:               t,
-			Status:             s,
-			Reason:             reason,
-			Message:            msg,
-			LastTransitionTime: now,
-		})
+		next.LastTransitionTime = now
+		*conds = append(*conds, next)
 	}
 
 	// Maintain lexicographic order after every mutation.
 	sortConditions(*conds)
+	return true
 }
 
 // -----------------------------------------------------------------------------
@@ -120,24 +164,77 @@ func set(conds *[]Condition, t Type, s ConditionStatus, reason, msg string) {
 // -----------------------------------------------------------------------------
 
 // MarkTrue sets the given condition to True.
-func MarkTrue(obj ConditionsAccessor, t Type) {
-	conds := append([]Condition(nil), obj.GetConditions()...) // copy for safety
-	set(&conds, t, True, "", "")
-	obj.SetConditions(conds)
+func MarkTrue(obj ConditionsAccessor, t Type, opts ...MarkOption) {
+	MarkTrueChanged(obj, t, opts...)
 }
 
 // MarkFalse sets the given condition to False.
-func MarkFalse(obj ConditionsAccessor, t Type, reason, msg string) {
-	conds := append([]Condition(nil), obj.GetConditions()...)
-	set(&conds, t, False, reason, msg)
-	obj.SetConditions(conds)
+func MarkFalse(obj ConditionsAccessor, t Type, reason, msg string, opts ...MarkOption) {
+	MarkFalseChanged(obj, t, reason, msg, opts...)
 }
 
 // MarkUnknown sets the given condition to Unknown.
-func MarkUnknown(obj ConditionsAccessor, t Type, reason, msg string) {
+func MarkUnknown(obj ConditionsAccessor, t Type, reason, msg string, opts ...MarkOption) {
+	MarkUnknownChanged(obj, t, reason, msg, opts...)
+}
+
+// MarkTrueChanged sets the given condition to True and reports whether the
+// slice actually mutated, so callers can skip a status write when nothing
+// meaningful changed.
+func MarkTrueChanged(obj ConditionsAccessor, t Type, opts ...MarkOption) bool {
+	conds := append([]Condition(nil), obj.GetConditions()...) // copy for safety
+	changed := set(&conds, t, True, "", "", opts...)
+	if changed {
+		obj.SetConditions(conds)
+	}
+	return changed
+}
+
+// MarkFalseChanged sets the given condition to False and reports whether the
+// slice actually mutated, so callers can skip a status write when nothing
+// meaningful changed.
+func MarkFalseChanged(obj ConditionsAccessor, t Type, reason, msg string, opts ...MarkOption) bool {
 	conds := append([]Condition(nil), obj.GetConditions()...)
-	set(&conds, t, Unknown, reason, msg)
-	obj.SetConditions(conds)
+	changed := set(&conds, t, False, reason, msg, opts...)
+	if changed {
+		obj.SetConditions(conds)
+	}
+	return changed
+}
+
+// MarkUnknownChanged sets the given condition to Unknown and reports whether
+// the slice actually mutated, so callers can skip a status write when nothing
+// meaningful changed.
+func MarkUnknownChanged(obj ConditionsAccessor, t Type, reason, msg string, opts ...MarkOption) bool {
+	conds := append([]Condition(nil), obj.GetConditions()...)
+	changed := set(&conds, t, Unknown, reason, msg, opts...)
+	if changed {
+		obj.SetConditions(conds)
+	}
+	return changed
+}
+
+// Equal reports whether a and b contain the same conditions, ignoring
+// LastTransitionTime and slice order.
+func Equal(a, b []Condition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byType := make(map[Type]Condition, len(a))
+	for _, c := range a {
+		byType[c.Type] = c
+	}
+	for _, c := range b {
+		other, ok := byType[c.Type]
+		if !ok {
+			return false
+		}
+		if other.Status != c.Status || other.Severity != c.Severity || other.Reason != c.Reason ||
+			other.Message != c.Message || other.ObservedGeneration != c.ObservedGeneration {
+			return false
+		}
+	}
+	return true
 }
 
 // IsTrue reports whether a condition of the given type is currently True.
@@ -152,37 +249,76 @@ func IsTrue(obj ConditionsAccessor, t Type) bool {
 // -----------------------------------------------------------------------------
 
 // SyncReady recomputes the Ready condition based on all other conditions:
-//   - Ready = False if at least one non‑Ready condition is False (Reason/Message
-//     are copied from the first False condition encountered).
-//   - Otherwise Ready = True.
+//   - Ready = False if at least one non‑Ready condition is False with
+//     Severity=Error (or no Severity set, for backward compatibility); Reason/
+//     Message are copied from the first such condition encountered.
+//   - Otherwise Ready = True, even if Warning/Info conditions are False.
+//   - The aggregate Ready.ObservedGeneration is the maximum ObservedGeneration
+//     seen across all non‑Ready conditions.
 //
 // Unknown conditions are ignored – they neither set Ready=False nor True.
 func SyncReady(obj ConditionsAccessor) {
+	syncReady(obj)
+}
+
+// SyncReadyChanged behaves like SyncReady but reports whether the Ready condition
+// actually mutated, so callers wrapped around ExternalStorage.UpdateStatus can skip
+// a status write when nothing meaningful changed.
+func SyncReadyChanged(obj ConditionsAccessor) bool {
+	changed, _ := syncReady(obj)
+	return changed
+}
+
+// SyncReadyWithSummary behaves like SyncReady, but also returns every non-Ready
+// False condition – not only the Severity=Error ones that drove Ready=False –
+// so callers can surface "degraded but ready" Warning/Info conditions that would
+// otherwise go unnoticed.
+func SyncReadyWithSummary(obj ConditionsAccessor) []Condition {
+	_, contributing := syncReady(obj)
+	return contributing
+}
+
+func syncReady(obj ConditionsAccessor) (changed bool, contributing []Condition) {
 	conds := append([]Condition(nil), obj.GetConditions()...)
 
 	var (
-		foundFalse   bool
-		falseReason  string
-		falseMessage string
+		foundError  bool
+		errReason   string
+		errMessage  string
+		maxObserved int64
 	)
 
 	for _, c := range conds {
 		if c.Type == Ready {
 			continue
 		}
-		if c.Status == False {
-			foundFalse = true
-			falseReason = c.Reason
-			falseMessage = c.Message
-			break
+		if c.ObservedGeneration > maxObserved {
+			maxObserved = c.ObservedGeneration
+		}
+		if c.Status != False {
+			continue
+		}
+		contributing = append(contributing, c)
+		if !foundError && c.Severity != SeverityWarning && c.Severity != SeverityInfo {
+			foundError = true
+			errReason = c.Reason
+			errMessage = c.Message
 		}
 	}
 
-	if foundFalse {
-		set(&conds, Ready, False, falseReason, falseMessage)
+	var opts []MarkOption
+	if maxObserved > 0 {
+		opts = append(opts, WithObservedGeneration(maxObserved))
+	}
+
+	if foundError {
+		changed = set(&conds, Ready, False, errReason, errMessage, opts...)
 	} else {
-		set(&conds, Ready, True, "", "")
+		changed = set(&conds, Ready, True, "", "", opts...)
 	}
 
-	obj.SetConditions(conds)
+	if changed {
+		obj.SetConditions(conds)
+	}
+	return changed, contributing
 }
@@ -87,3 +87,125 @@ func TestLastTransitionTimeUpdate(t *testing.T) {
 		t.Fatalf("LastTransitionTime not updated on status change")
 	}
 }
+
+func TestMarkChangedNoOp(t *testing.T) {
+	obj := &stub{}
+	if !MarkFalseChanged(obj, Type("Cache"), "CacheDown", "cache offline") {
+		t.Fatalf("expected first mark to report changed")
+	}
+	cond := get(&obj.conds, Type("Cache"))
+	firstTime := cond.LastTransitionTime
+
+	if MarkFalseChanged(obj, Type("Cache"), "CacheDown", "cache offline") {
+		t.Fatalf("expected identical mark to report unchanged")
+	}
+	cond = get(&obj.conds, Type("Cache"))
+	if !cond.LastTransitionTime.Equal(firstTime) {
+		t.Fatalf("LastTransitionTime must not move on a no-op mark")
+	}
+}
+
+func TestMarkChangedStatusFlipWithSameReason(t *testing.T) {
+	obj := &stub{}
+	MarkFalseChanged(obj, Type("Cache"), "", "")
+	cond := get(&obj.conds, Type("Cache"))
+	firstTime := cond.LastTransitionTime
+
+	time.Sleep(1 * time.Millisecond)
+	changed := MarkTrueChanged(obj, Type("Cache"))
+	if !changed {
+		t.Fatalf("expected status flip to report changed even with empty reason")
+	}
+	cond = get(&obj.conds, Type("Cache"))
+	if !cond.LastTransitionTime.After(firstTime) {
+		t.Fatalf("LastTransitionTime must move when Status flips, regardless of Reason")
+	}
+}
+
+func TestSyncReadyChangedNoOp(t *testing.T) {
+	obj := &stub{}
+	MarkTrue(obj, Type("API"))
+
+	if !SyncReadyChanged(obj) {
+		t.Fatalf("expected first sync to report changed")
+	}
+	if SyncReadyChanged(obj) {
+		t.Fatalf("expected repeat sync with no new transitions to report unchanged")
+	}
+}
+
+func TestSyncReadyIgnoresWarningSeverity(t *testing.T) {
+	obj := &stub{}
+	MarkTrue(obj, Type("API"))
+	MarkFalse(obj, Type("Cache"), "CacheCold", "cache warming up", WithSeverity(SeverityWarning))
+
+	SyncReady(obj)
+
+	if !IsTrue(obj, Ready) {
+		t.Fatalf("Ready should stay true when only Warning-severity conditions are false")
+	}
+}
+
+func TestSyncReadyErrorSeverityForcesNotReady(t *testing.T) {
+	obj := &stub{}
+	MarkTrue(obj, Type("API"))
+	MarkFalse(obj, Type("Database"), "DBDown", "database unreachable", WithSeverity(SeverityError))
+
+	SyncReady(obj)
+
+	if IsTrue(obj, Ready) {
+		t.Fatalf("Ready should be false when a Severity=Error condition is false")
+	}
+}
+
+func TestSyncReadyDefaultSeverityIsError(t *testing.T) {
+	obj := &stub{}
+	MarkFalse(obj, Type("Database"), "DBDown", "database unreachable")
+
+	SyncReady(obj)
+
+	if IsTrue(obj, Ready) {
+		t.Fatalf("a False condition with no Severity set must still force Ready=False")
+	}
+}
+
+func TestSyncReadyObservedGeneration(t *testing.T) {
+	obj := &stub{}
+	MarkTrue(obj, Type("API"), WithObservedGeneration(3))
+	MarkFalse(obj, Type("Cache"), "CacheCold", "cache warming up", WithSeverity(SeverityWarning), WithObservedGeneration(5))
+
+	SyncReady(obj)
+
+	ready := get(&obj.conds, Ready)
+	if ready.ObservedGeneration != 5 {
+		t.Fatalf("expected Ready.ObservedGeneration to be the max seen (5), got %d", ready.ObservedGeneration)
+	}
+}
+
+func TestSyncReadyWithSummary(t *testing.T) {
+	obj := &stub{}
+	MarkTrue(obj, Type("API"))
+	MarkFalse(obj, Type("Cache"), "CacheCold", "cache warming up", WithSeverity(SeverityWarning))
+
+	summary := SyncReadyWithSummary(obj)
+
+	if !IsTrue(obj, Ready) {
+		t.Fatalf("Ready should stay true with only a Warning-severity False condition")
+	}
+	if len(summary) != 1 || summary[0].Type != Type("Cache") {
+		t.Fatalf("expected summary to contain the Cache condition, got %+v", summary)
+	}
+}
+
+func TestEqualIgnoresLastTransitionTime(t *testing.T) {
+	a := []Condition{{Type: "Ready", Status: True, LastTransitionTime: time.Now()}}
+	b := []Condition{{Type: "Ready", Status: True, LastTransitionTime: time.Now().Add(time.Hour)}}
+	if !Equal(a, b) {
+		t.Fatalf("expected Equal to ignore LastTransitionTime")
+	}
+
+	c := []Condition{{Type: "Ready", Status: False}}
+	if Equal(a, c) {
+		t.Fatalf("expected Equal to detect differing Status")
+	}
+}
@@ -2,8 +2,12 @@ package resource
 
 import "context"
 
-const MessageTypeUpdate = "update"
-const MessageTypeDelete = "delete"
+const (
+	MessageTypeCreate       = "create"
+	MessageTypeUpdate       = "update"
+	MessageTypeStatusUpdate = "status_update"
+	MessageTypeDelete       = "delete"
+)
 
 type ExternalStorage[T Object[T]] interface {
 	Create(ctx context.Context, item T) error
@@ -15,7 +19,57 @@ type ExternalStorage[T Object[T]] interface {
 	Delete(ctx context.Context, shardID string, groupKind GroupKind, objectKey ObjectKey) error
 }
 
+// Filter scopes which messages a Listen subscriber receives. A zero-value Filter
+// delivers every shard, GroupKind and message type; a non-empty GroupKinds or
+// MessageTypes slice is matched as a set (any one of them), while ShardID is an
+// exact match. This lets one ExternalListener connection drive multiple
+// reconcilers without each of them dispatching on every message it isn't
+// interested in.
+type Filter struct {
+	ShardID      string
+	GroupKinds   []GroupKind
+	MessageTypes []string
+}
+
+// Matches reports whether a message with the given shardID, kind and messageType
+// passes the filter. Implementations of ExternalListener can use it directly.
+func (f Filter) Matches(shardID string, kind GroupKind, messageType string) bool {
+	if f.ShardID != "" && f.ShardID != shardID {
+		return false
+	}
+	if len(f.GroupKinds) > 0 {
+		found := false
+		for _, gk := range f.GroupKinds {
+			if gk == kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.MessageTypes) > 0 {
+		found := false
+		for _, mt := range f.MessageTypes {
+			if mt == messageType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// ExternalListener is an event bus over changes seen by an ExternalStorage: each
+// message carries the shard and object it concerns, a MessageType* describing
+// what happened, and an ack func the subscriber calls once it has durably
+// handled the message. filter scopes which messages f receives so multiple
+// reconcilers can share one connection.
 type ExternalListener interface {
-	Listen(f func(ctx context.Context, kind GroupKind, objectKey ObjectKey, messageType string, ack func()))
+	Listen(filter Filter, f func(ctx context.Context, shardID string, kind GroupKind, objectKey ObjectKey, messageType string, ack func()))
 	ClearQueue(ctx context.Context) error
 }
@@ -0,0 +1,58 @@
+package workqueue
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucketRateLimiter is a simple token-bucket limiter shared across all items,
+// used to cap the overall retry rate so a storm of failing items cannot starve
+// unrelated ones. It ignores per-item failure history entirely; NumRequeues always
+// returns 0 and Forget is a no-op.
+type TokenBucketRateLimiter struct {
+	mu sync.Mutex
+
+	qps    float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now func() time.Time
+}
+
+// NewTokenBucketRateLimiter returns a limiter that refills at qps tokens per second
+// up to a maximum of burst tokens, starting full.
+func NewTokenBucketRateLimiter(qps float64, burst int) *TokenBucketRateLimiter {
+	return &TokenBucketRateLimiter{
+		qps:    qps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+		last:   time.Now(),
+	}
+}
+
+func (r *TokenBucketRateLimiter) When(item interface{}) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.tokens += now.Sub(r.last).Seconds() * r.qps
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+
+	r.tokens--
+	if r.tokens >= 0 {
+		return 0
+	}
+
+	// A negative balance reserves tokens that have not refilled yet, so each
+	// caller waits behind the ones before it.
+	return time.Duration(-r.tokens / r.qps * float64(time.Second))
+}
+
+func (r *TokenBucketRateLimiter) NumRequeues(item interface{}) int { return 0 }
+
+func (r *TokenBucketRateLimiter) Forget(item interface{}) {}
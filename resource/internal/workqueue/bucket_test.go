@@ -0,0 +1,57 @@
+package workqueue
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucketRateLimiterDepletesAndWaits(t *testing.T) {
+	r := NewTokenBucketRateLimiter(1, 2)
+	clock := time.Now()
+	r.now = func() time.Time { return clock }
+	r.last = clock
+
+	if d := r.When("a"); d != 0 {
+		t.Fatalf("expected first token to be free, got %v", d)
+	}
+	if d := r.When("b"); d != 0 {
+		t.Fatalf("expected burst token to be free, got %v", d)
+	}
+
+	d := r.When("c")
+	if d <= 0 {
+		t.Fatalf("expected a positive wait once the bucket is empty, got %v", d)
+	}
+	if d > time.Second {
+		t.Fatalf("expected wait to be about 1s at 1 qps, got %v", d)
+	}
+}
+
+func TestTokenBucketRateLimiterRefillsOverTime(t *testing.T) {
+	r := NewTokenBucketRateLimiter(1, 1)
+	clock := time.Now()
+	r.now = func() time.Time { return clock }
+	r.last = clock
+
+	if d := r.When("a"); d != 0 {
+		t.Fatalf("expected first token to be free, got %v", d)
+	}
+	if d := r.When("a"); d <= 0 {
+		t.Fatalf("expected bucket to be empty immediately after, got %v", d)
+	}
+
+	// The second call reserved the token due at +1s, so a free token is next
+	// available at +2s.
+	clock = clock.Add(2 * time.Second)
+	if d := r.When("a"); d != 0 {
+		t.Fatalf("expected two seconds to repay the reserved token and refill one at 1 qps, got %v", d)
+	}
+}
+
+func TestTokenBucketRateLimiterForgetAndNumRequeuesAreNoOps(t *testing.T) {
+	r := NewTokenBucketRateLimiter(5, 5)
+	r.Forget("anything")
+	if n := r.NumRequeues("anything"); n != 0 {
+		t.Fatalf("TokenBucketRateLimiter tracks no per-item state, want 0, got %d", n)
+	}
+}
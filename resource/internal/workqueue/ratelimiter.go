@@ -0,0 +1,105 @@
+// Package workqueue provides a small, dependency-free rate limiter modeled on
+// client-go's util/workqueue package. It is used internally by RetryingListener
+// to back off retries of failed item processing without pulling in k8s.io/client-go.
+package workqueue
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter decides how long to wait before an item should be retried again.
+type RateLimiter interface {
+	// When returns the duration an item should wait before being processed again.
+	When(item interface{}) time.Duration
+	// Forget clears all tracked failure state for item.
+	Forget(item interface{})
+	// NumRequeues returns how many times item has been requeued.
+	NumRequeues(item interface{}) int
+}
+
+// ItemFastSlowRateLimiter retries an item at fastDelay for the first maxFastAttempts
+// failures, then falls back to slowDelay for every attempt after that.
+type ItemFastSlowRateLimiter struct {
+	mu sync.Mutex
+
+	failures map[interface{}]int
+
+	fastDelay       time.Duration
+	slowDelay       time.Duration
+	maxFastAttempts int
+}
+
+// NewItemFastSlowRateLimiter returns a RateLimiter that yields fastDelay for the
+// first maxFastAttempts retries of an item, then slowDelay afterwards.
+func NewItemFastSlowRateLimiter(fastDelay, slowDelay time.Duration, maxFastAttempts int) *ItemFastSlowRateLimiter {
+	return &ItemFastSlowRateLimiter{
+		failures:        map[interface{}]int{},
+		fastDelay:       fastDelay,
+		slowDelay:       slowDelay,
+		maxFastAttempts: maxFastAttempts,
+	}
+}
+
+func (r *ItemFastSlowRateLimiter) When(item interface{}) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failures[item] = r.failures[item] + 1
+
+	if r.failures[item] <= r.maxFastAttempts {
+		return r.fastDelay
+	}
+	return r.slowDelay
+}
+
+func (r *ItemFastSlowRateLimiter) NumRequeues(item interface{}) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failures[item]
+}
+
+func (r *ItemFastSlowRateLimiter) Forget(item interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.failures, item)
+}
+
+// MaxOfRateLimiter combines several RateLimiters and always waits as long as the
+// slowest of them demands, so no single limiter's patience can be bypassed by
+// satisfying another.
+type MaxOfRateLimiter struct {
+	limiters []RateLimiter
+}
+
+// NewMaxOfRateLimiter returns a RateLimiter that delegates to limiters and takes
+// the maximum of their When() results.
+func NewMaxOfRateLimiter(limiters ...RateLimiter) *MaxOfRateLimiter {
+	return &MaxOfRateLimiter{limiters: limiters}
+}
+
+func (r *MaxOfRateLimiter) When(item interface{}) time.Duration {
+	var longest time.Duration
+	for _, limiter := range r.limiters {
+		if d := limiter.When(item); d > longest {
+			longest = d
+		}
+	}
+	return longest
+}
+
+func (r *MaxOfRateLimiter) NumRequeues(item interface{}) int {
+	var max int
+	for _, limiter := range r.limiters {
+		if n := limiter.NumRequeues(item); n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+func (r *MaxOfRateLimiter) Forget(item interface{}) {
+	for _, limiter := range r.limiters {
+		limiter.Forget(item)
+	}
+}
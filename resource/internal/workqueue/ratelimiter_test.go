@@ -0,0 +1,82 @@
+package workqueue
+
+import (
+	"testing"
+	"time"
+)
+
+func TestItemFastSlowRateLimiterBoundary(t *testing.T) {
+	r := NewItemFastSlowRateLimiter(10*time.Millisecond, time.Minute, 2)
+
+	for i := 0; i < 2; i++ {
+		if d := r.When("item"); d != 10*time.Millisecond {
+			t.Fatalf("attempt %d: want fastDelay, got %v", i+1, d)
+		}
+	}
+	if d := r.When("item"); d != time.Minute {
+		t.Fatalf("attempt 3 (past maxFastAttempts): want slowDelay, got %v", d)
+	}
+	if d := r.When("item"); d != time.Minute {
+		t.Fatalf("attempt 4: want slowDelay, got %v", d)
+	}
+}
+
+func TestItemFastSlowRateLimiterPerItem(t *testing.T) {
+	r := NewItemFastSlowRateLimiter(10*time.Millisecond, time.Minute, 1)
+
+	r.When("a")
+	if d := r.When("a"); d != time.Minute {
+		t.Fatalf("item a should have exhausted its fast attempt, got %v", d)
+	}
+	if d := r.When("b"); d != 10*time.Millisecond {
+		t.Fatalf("item b should start fresh on its own fast attempt, got %v", d)
+	}
+}
+
+func TestItemFastSlowRateLimiterForget(t *testing.T) {
+	r := NewItemFastSlowRateLimiter(10*time.Millisecond, time.Minute, 1)
+
+	r.When("item")
+	r.When("item") // now in slow phase
+	if n := r.NumRequeues("item"); n != 2 {
+		t.Fatalf("expected 2 requeues, got %d", n)
+	}
+
+	r.Forget("item")
+	if n := r.NumRequeues("item"); n != 0 {
+		t.Fatalf("expected Forget to reset requeue count, got %d", n)
+	}
+	if d := r.When("item"); d != 10*time.Millisecond {
+		t.Fatalf("expected a forgotten item to restart at fastDelay, got %v", d)
+	}
+}
+
+type constRateLimiter time.Duration
+
+func (c constRateLimiter) When(item interface{}) time.Duration { return time.Duration(c) }
+func (c constRateLimiter) Forget(item interface{})             {}
+func (c constRateLimiter) NumRequeues(item interface{}) int    { return 0 }
+
+func TestMaxOfRateLimiterTakesMax(t *testing.T) {
+	r := NewMaxOfRateLimiter(constRateLimiter(5*time.Millisecond), constRateLimiter(50*time.Millisecond), constRateLimiter(20*time.Millisecond))
+
+	if d := r.When("item"); d != 50*time.Millisecond {
+		t.Fatalf("expected MaxOfRateLimiter to take the longest delay, got %v", d)
+	}
+}
+
+func TestMaxOfRateLimiterForgetsAll(t *testing.T) {
+	fastSlow := NewItemFastSlowRateLimiter(10*time.Millisecond, time.Minute, 1)
+	r := NewMaxOfRateLimiter(fastSlow, constRateLimiter(0))
+
+	r.When("item")
+	r.When("item")
+	if n := fastSlow.NumRequeues("item"); n != 2 {
+		t.Fatalf("expected underlying limiter to have 2 requeues, got %d", n)
+	}
+
+	r.Forget("item")
+	if n := fastSlow.NumRequeues("item"); n != 0 {
+		t.Fatalf("expected Forget to propagate to all wrapped limiters, got %d", n)
+	}
+}
@@ -0,0 +1,223 @@
+package resource
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// defaultPageLimit is used by ListPendingPage implementations in this package
+// when PageOpts.Limit is left unset.
+const defaultPageLimit = 100
+
+// PageOpts configures a single ListPendingPage call. A zero-value PageOpts
+// requests the first page with an implementation-defined default limit.
+type PageOpts struct {
+	// Cursor is opaque; callers must pass back exactly what a previous
+	// ListPendingPage call returned as nextCursor, and must treat "" as "start
+	// from the beginning".
+	Cursor string
+	// Limit caps the number of items returned. <= 0 means implementation default.
+	Limit int
+}
+
+// PendingEvent is a single item delivered by WatchPending, or a terminal error
+// that closes the channel.
+type PendingEvent[T any] struct {
+	Item T
+	Err  error
+}
+
+// PagedPendingLister is an optional extension of ExternalStorage for
+// implementations that can page through a shard's pending backlog instead of
+// returning it all at once, so a backlog of thousands of pending objects
+// doesn't have to fit in memory at once. Use AdaptListPending to satisfy it
+// from a plain ExternalStorage.
+type PagedPendingLister[T Object[T]] interface {
+	ListPendingPage(ctx context.Context, shardID string, groupKind GroupKind, opts PageOpts) (items []T, nextCursor string, err error)
+}
+
+// PendingWatcher is an optional extension of ExternalStorage for
+// implementations that can stream newly-pending items rather than requiring
+// callers to poll ListPending/ListPendingPage. The returned channel closes
+// once ctx is done.
+type PendingWatcher[T Object[T]] interface {
+	WatchPending(ctx context.Context, shardID string, groupKind GroupKind) (<-chan PendingEvent[T], error)
+}
+
+// listPendingAdapter satisfies PagedPendingLister for any ExternalStorage by
+// calling ListPending once per page request and serving the result back in
+// Limit-sized chunks. It does not bound memory during the underlying call;
+// implementations that need that should implement PagedPendingLister directly.
+type listPendingAdapter[T Object[T]] struct {
+	storage ExternalStorage[T]
+}
+
+// AdaptListPending wraps storage so its plain ListPending satisfies
+// PagedPendingLister.
+func AdaptListPending[T Object[T]](storage ExternalStorage[T]) PagedPendingLister[T] {
+	return &listPendingAdapter[T]{storage: storage}
+}
+
+func (a *listPendingAdapter[T]) ListPendingPage(ctx context.Context, shardID string, groupKind GroupKind, opts PageOpts) ([]T, string, error) {
+	items, err := a.storage.ListPending(ctx, shardID, groupKind)
+	if err != nil {
+		return nil, "", err
+	}
+	return paginateOffset(items, opts)
+}
+
+// paginateOffset slices items according to opts, treating Cursor as an opaque
+// encoding of an offset into items.
+func paginateOffset[T any](items []T, opts PageOpts) ([]T, string, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+
+	offset, err := decodeOffsetCursor(opts.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if offset >= len(items) {
+		return nil, "", nil
+	}
+
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+
+	page := append([]T(nil), items[offset:end]...)
+
+	var next string
+	if end < len(items) {
+		next = encodeOffsetCursor(end)
+	}
+	return page, next, nil
+}
+
+func encodeOffsetCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeOffsetCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("resource: invalid page cursor: %w", err)
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("resource: invalid page cursor %q", cursor)
+	}
+	return offset, nil
+}
+
+// pendingWatcher is a single WatchPending subscription. closed is guarded by
+// the owning InMemoryPendingStore's mu, so Add and the ctx-cancellation
+// cleanup goroutine can never race over whether ch is still open to send on.
+type pendingWatcher[T any] struct {
+	ch     chan PendingEvent[T]
+	closed bool
+}
+
+// InMemoryPendingStore is a default, dependency-free PagedPendingLister and
+// PendingWatcher implementation for tests: callers mark items pending with
+// Add, and subscribers observe them through ListPending, ListPendingPage and
+// WatchPending exactly as they would against a real ExternalStorage-backed
+// listener. It stores items opaquely, so unlike listPendingAdapter it is not
+// constrained to Object[T].
+type InMemoryPendingStore[T any] struct {
+	mu       sync.Mutex
+	items    map[GroupKind]map[string][]T
+	watchers map[GroupKind]map[string][]*pendingWatcher[T]
+}
+
+// NewInMemoryPendingStore returns an empty InMemoryPendingStore.
+func NewInMemoryPendingStore[T any]() *InMemoryPendingStore[T] {
+	return &InMemoryPendingStore[T]{
+		items:    make(map[GroupKind]map[string][]T),
+		watchers: make(map[GroupKind]map[string][]*pendingWatcher[T]),
+	}
+}
+
+// Add marks item as pending for shardID/groupKind, delivering it to any open
+// WatchPending subscribers for that shard/groupKind. Delivery is
+// non-blocking: a subscriber whose buffer is full misses the event rather
+// than stalling Add or any other subscriber.
+func (s *InMemoryPendingStore[T]) Add(shardID string, groupKind GroupKind, item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.items[groupKind] == nil {
+		s.items[groupKind] = make(map[string][]T)
+	}
+	s.items[groupKind][shardID] = append(s.items[groupKind][shardID], item)
+
+	for _, w := range s.watchers[groupKind][shardID] {
+		if w.closed {
+			continue
+		}
+		select {
+		case w.ch <- PendingEvent[T]{Item: item}:
+		default:
+		}
+	}
+}
+
+// ListPending implements the plain ExternalStorage.ListPending contract.
+func (s *InMemoryPendingStore[T]) ListPending(ctx context.Context, shardID string, groupKind GroupKind) ([]T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]T(nil), s.items[groupKind][shardID]...), nil
+}
+
+// ListPendingPage implements PagedPendingLister.
+func (s *InMemoryPendingStore[T]) ListPendingPage(ctx context.Context, shardID string, groupKind GroupKind, opts PageOpts) ([]T, string, error) {
+	items, err := s.ListPending(ctx, shardID, groupKind)
+	if err != nil {
+		return nil, "", err
+	}
+	return paginateOffset(items, opts)
+}
+
+// WatchPending implements PendingWatcher. The returned channel is buffered
+// (16 events); a subscriber that doesn't keep up with Add misses events
+// rather than blocking the producer. The channel is closed once ctx is done,
+// and never for any other reason.
+func (s *InMemoryPendingStore[T]) WatchPending(ctx context.Context, shardID string, groupKind GroupKind) (<-chan PendingEvent[T], error) {
+	w := &pendingWatcher[T]{ch: make(chan PendingEvent[T], 16)}
+
+	s.mu.Lock()
+	if s.watchers[groupKind] == nil {
+		s.watchers[groupKind] = make(map[string][]*pendingWatcher[T])
+	}
+	s.watchers[groupKind][shardID] = append(s.watchers[groupKind][shardID], w)
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// Mark closed before close() so a concurrent Add that is waiting on mu
+		// sees closed==true and skips the send instead of racing close().
+		w.closed = true
+		close(w.ch)
+
+		watchers := s.watchers[groupKind][shardID]
+		for i, other := range watchers {
+			if other == w {
+				s.watchers[groupKind][shardID] = append(watchers[:i], watchers[i+1:]...)
+				break
+			}
+		}
+	}()
+
+	return w.ch, nil
+}
@@ -0,0 +1,137 @@
+package resource
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestPaginateOffsetMultiPageWalk(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6}
+
+	page1, cursor1, err := paginateOffset(items, PageOpts{Limit: 3})
+	if err != nil {
+		t.Fatalf("page1: %v", err)
+	}
+	if !equalInts(page1, []int{0, 1, 2}) || cursor1 == "" {
+		t.Fatalf("page1 = %v, cursor %q", page1, cursor1)
+	}
+
+	page2, cursor2, err := paginateOffset(items, PageOpts{Limit: 3, Cursor: cursor1})
+	if err != nil {
+		t.Fatalf("page2: %v", err)
+	}
+	if !equalInts(page2, []int{3, 4, 5}) || cursor2 == "" {
+		t.Fatalf("page2 = %v, cursor %q", page2, cursor2)
+	}
+
+	page3, cursor3, err := paginateOffset(items, PageOpts{Limit: 3, Cursor: cursor2})
+	if err != nil {
+		t.Fatalf("page3: %v", err)
+	}
+	if !equalInts(page3, []int{6}) || cursor3 != "" {
+		t.Fatalf("page3 = %v, cursor %q, want no more pages", page3, cursor3)
+	}
+}
+
+func TestPaginateOffsetFinalEmptyPage(t *testing.T) {
+	items := []int{0, 1, 2}
+
+	_, cursor, err := paginateOffset(items, PageOpts{Limit: 3})
+	if err != nil {
+		t.Fatalf("page1: %v", err)
+	}
+	if cursor != "" {
+		t.Fatalf("expected no nextCursor once the full set fits in one page, got %q", cursor)
+	}
+
+	last := encodeOffsetCursor(len(items))
+	page, cursor, err := paginateOffset(items, PageOpts{Cursor: last})
+	if err != nil {
+		t.Fatalf("trailing page: %v", err)
+	}
+	if len(page) != 0 || cursor != "" {
+		t.Fatalf("expected an empty final page, got items=%v cursor=%q", page, cursor)
+	}
+}
+
+func TestDecodeOffsetCursorInvalid(t *testing.T) {
+	if _, err := decodeOffsetCursor("not-base64!!"); err == nil {
+		t.Fatalf("expected an error for a malformed cursor")
+	}
+	notANumber := base64.RawURLEncoding.EncodeToString([]byte("not-a-number"))
+	if _, err := decodeOffsetCursor(notANumber); err == nil {
+		t.Fatalf("expected an error for a cursor that doesn't decode to an offset")
+	}
+	if offset, err := decodeOffsetCursor(""); err != nil || offset != 0 {
+		t.Fatalf("expected empty cursor to decode to offset 0, got %d, %v", offset, err)
+	}
+}
+
+func TestInMemoryPendingStoreListAndPage(t *testing.T) {
+	store := NewInMemoryPendingStore[int]()
+	for i := 0; i < 5; i++ {
+		store.Add("shard-a", GroupKind{}, i)
+	}
+
+	all, err := store.ListPending(context.Background(), "shard-a", GroupKind{})
+	if err != nil {
+		t.Fatalf("ListPending: %v", err)
+	}
+	if !equalInts(all, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("ListPending = %v", all)
+	}
+
+	page, cursor, err := store.ListPendingPage(context.Background(), "shard-a", GroupKind{}, PageOpts{Limit: 2})
+	if err != nil {
+		t.Fatalf("ListPendingPage: %v", err)
+	}
+	if !equalInts(page, []int{0, 1}) || cursor == "" {
+		t.Fatalf("ListPendingPage = %v, cursor %q", page, cursor)
+	}
+}
+
+func TestInMemoryPendingStoreWatchPendingDeliversAndClosesOnCancel(t *testing.T) {
+	store := NewInMemoryPendingStore[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch, err := store.WatchPending(ctx, "shard-a", GroupKind{})
+	if err != nil {
+		t.Fatalf("WatchPending: %v", err)
+	}
+
+	store.Add("shard-a", GroupKind{}, 42)
+
+	select {
+	case ev := <-ch:
+		if ev.Item != 42 {
+			t.Fatalf("expected item 42, got %v", ev.Item)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for watched item")
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatalf("expected channel to be closed after ctx cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for channel to close")
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,125 @@
+package resource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/reconcile-kit/api/resource/internal/workqueue"
+)
+
+const (
+	fastRetryDelay     = 50 * time.Millisecond
+	fastRetryAttempts  = 20
+	defaultBucketQPS   = 5
+	defaultBucketBurst = 20
+)
+
+// RetryingHandlerFunc is the callback signature for RetryingListener. Unlike
+// ExternalListener's callback, it returns an error instead of calling ack() itself:
+// a nil error acks the item, a non-nil error re-enqueues it through the rate limiter.
+type RetryingHandlerFunc func(ctx context.Context, kind GroupKind, objectKey ObjectKey, messageType string) error
+
+// retryKey identifies a single item for rate limiting and de-duplication purposes.
+type retryKey struct {
+	shardID     string
+	kind        GroupKind
+	objectKey   ObjectKey
+	messageType string
+}
+
+// RetryingListener wraps an ExternalListener and retries failed handler
+// invocations against ExternalStorage with a workqueue-style rate limiter, so
+// callers don't have to build their own backoff around transient failures.
+//
+// Retries combine a per-item fast/slow limiter - roughly 20 near-instant attempts
+// at a fixed delay, then maxDelay afterwards - with an overall token-bucket
+// limiter, so a storm of failing items cannot starve unrelated ones.
+type RetryingListener struct {
+	inner   ExternalListener
+	shardID string
+	limiter workqueue.RateLimiter
+
+	mu     sync.Mutex
+	timers map[retryKey]*time.Timer
+}
+
+// NewRetryingListener wraps inner for shardID. maxDelay bounds the per-item
+// backoff once its fast retries are exhausted; bucketQPS/bucketBurst bound the
+// overall retry rate across all items. bucketQPS <= 0 and bucketBurst <= 0 fall
+// back to sane defaults (5 events/sec, burst 20).
+func NewRetryingListener(inner ExternalListener, shardID string, maxDelay time.Duration, bucketQPS float64, bucketBurst int) *RetryingListener {
+	if bucketQPS <= 0 {
+		bucketQPS = defaultBucketQPS
+	}
+	if bucketBurst <= 0 {
+		bucketBurst = defaultBucketBurst
+	}
+	return &RetryingListener{
+		inner:   inner,
+		shardID: shardID,
+		limiter: workqueue.NewMaxOfRateLimiter(
+			workqueue.NewItemFastSlowRateLimiter(fastRetryDelay, maxDelay, fastRetryAttempts),
+			workqueue.NewTokenBucketRateLimiter(bucketQPS, bucketBurst),
+		),
+		timers: make(map[retryKey]*time.Timer),
+	}
+}
+
+// Listen subscribes to the wrapped ExternalListener, scoped to this listener's
+// shardID. A handler error schedules a retry of the same item through the rate
+// limiter instead of acking it; a nil error acks immediately and forgets any
+// pending backoff for that item.
+func (l *RetryingListener) Listen(f RetryingHandlerFunc) {
+	l.inner.Listen(Filter{ShardID: l.shardID}, func(ctx context.Context, shardID string, kind GroupKind, objectKey ObjectKey, messageType string, ack func()) {
+		key := retryKey{shardID: shardID, kind: kind, objectKey: objectKey, messageType: messageType}
+		l.handle(ctx, f, key, ack)
+	})
+}
+
+func (l *RetryingListener) handle(ctx context.Context, f RetryingHandlerFunc, key retryKey, ack func()) {
+	if err := f(ctx, key.kind, key.objectKey, key.messageType); err != nil {
+		l.retryLater(ctx, f, key, ack)
+		return
+	}
+	l.limiter.Forget(key)
+	ack()
+}
+
+func (l *RetryingListener) retryLater(ctx context.Context, f RetryingHandlerFunc, key retryKey, ack func()) {
+	delay := l.limiter.When(key)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if t, ok := l.timers[key]; ok {
+		t.Stop()
+	}
+	l.timers[key] = time.AfterFunc(delay, func() {
+		l.mu.Lock()
+		delete(l.timers, key)
+		l.mu.Unlock()
+		l.handle(ctx, f, key, ack)
+	})
+}
+
+// Forget drops any retry state for the given item. Callers that treat a failure
+// as terminal should call this so the limiter's bookkeeping doesn't grow
+// unbounded, and so a later item with the same identity starts from fast retries.
+func (l *RetryingListener) Forget(kind GroupKind, objectKey ObjectKey, messageType string) {
+	key := retryKey{shardID: l.shardID, kind: kind, objectKey: objectKey, messageType: messageType}
+
+	l.mu.Lock()
+	if t, ok := l.timers[key]; ok {
+		t.Stop()
+		delete(l.timers, key)
+	}
+	l.mu.Unlock()
+
+	l.limiter.Forget(key)
+}
+
+// ClearQueue proxies to the wrapped listener.
+func (l *RetryingListener) ClearQueue(ctx context.Context) error {
+	return l.inner.ClearQueue(ctx)
+}